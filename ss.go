@@ -9,20 +9,37 @@ import (
 	"golang.org/x/exp/constraints"
 )
 
-// SortedSlice is a thread-safe, sorted slice that stores key-value pairs.
-type SortedSlice[K constraints.Ordered, V any] struct {
+// SortedSlice is a thread-safe, sorted slice that stores key-value pairs,
+// ordered either by K's natural ordering (via New) or by a caller-supplied
+// comparator (via NewFunc). All internal searches route through cmp, so
+// both construction styles share one implementation.
+type SortedSlice[K comparable, V any] struct {
 	sync.RWMutex
 	data []kv[K, V]
+	cmp  func(a, b K) int
 }
 
-type kv[K constraints.Ordered, V any] struct {
+type kv[K comparable, V any] struct {
 	Key   K
 	Value V
 }
 
-// New creates a new SortedSlice.
+// New creates a new SortedSlice ordered by K's natural ordering. Use
+// NewFunc for key types that constraints.Ordered can't express.
 func New[K constraints.Ordered, V any]() *SortedSlice[K, V] {
-	return &SortedSlice[K, V]{}
+	return &SortedSlice[K, V]{cmp: compareOrdered[K]}
+}
+
+// compareOrdered is the default comparator used by New.
+func compareOrdered[K constraints.Ordered](a, b K) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
 }
 
 // Add adds a value to the slice associated with the given key.
@@ -31,10 +48,10 @@ func (ss *SortedSlice[K, V]) Add(key K, value V) {
 	defer ss.Unlock()
 
 	index := sort.Search(len(ss.data), func(i int) bool {
-		return ss.data[i].Key >= key
+		return ss.cmp(ss.data[i].Key, key) >= 0
 	})
 
-	if index < len(ss.data) && ss.data[index].Key == key {
+	if index < len(ss.data) && ss.cmp(ss.data[index].Key, key) == 0 {
 		// Key already exists, replace the value
 		ss.data[index].Value = value
 	} else {
@@ -61,10 +78,10 @@ func (ss *SortedSlice[K, V]) Get(key K) (V, bool) {
 	defer ss.RUnlock()
 
 	index := sort.Search(len(ss.data), func(i int) bool {
-		return ss.data[i].Key >= key
+		return ss.cmp(ss.data[i].Key, key) >= 0
 	})
 
-	if index < len(ss.data) && ss.data[index].Key == key {
+	if index < len(ss.data) && ss.cmp(ss.data[index].Key, key) == 0 {
 		return ss.data[index].Value, true
 	}
 	var zero V
@@ -82,10 +99,10 @@ func (ss *SortedSlice[K, V]) Exist(key K) bool {
 	defer ss.RUnlock()
 
 	index := sort.Search(len(ss.data), func(i int) bool {
-		return ss.data[i].Key >= key
+		return ss.cmp(ss.data[i].Key, key) >= 0
 	})
 
-	return index < len(ss.data) && ss.data[index].Key == key
+	return index < len(ss.data) && ss.cmp(ss.data[index].Key, key) == 0
 }
 
 // Delete removes a key-value pair from the slice.
@@ -94,10 +111,10 @@ func (ss *SortedSlice[K, V]) Delete(key K) (V, bool) {
 	defer ss.Unlock()
 
 	index := sort.Search(len(ss.data), func(i int) bool {
-		return ss.data[i].Key >= key
+		return ss.cmp(ss.data[i].Key, key) >= 0
 	})
 
-	if index < len(ss.data) && ss.data[index].Key == key {
+	if index < len(ss.data) && ss.cmp(ss.data[index].Key, key) == 0 {
 		value := ss.data[index].Value
 		ss.data = append(ss.data[:index], ss.data[index+1:]...)
 		return value, true
@@ -127,7 +144,10 @@ func (ss *SortedSlice[K, V]) Clear() {
 	ss.data = nil
 }
 
-// Load reads the slice from a file using gob encoding.
+// Load reads the slice from a file, decoding the whole backing slice as a
+// single gob value. This is the original on-disk format, kept as-is so
+// files written by Save remain loadable; use DecodeFrom with a Codec of
+// your choice for the newer streaming, pluggable-format API.
 func (ss *SortedSlice[K, V]) Load(filename string) error {
 	ss.Lock()
 	defer ss.Unlock()
@@ -142,7 +162,10 @@ func (ss *SortedSlice[K, V]) Load(filename string) error {
 	return decoder.Decode(&ss.data)
 }
 
-// Save writes the slice to a file using gob encoding.
+// Save writes the slice to a file, encoding the whole backing slice as a
+// single gob value. This is the original on-disk format, kept as-is for
+// compatibility with existing saved files; use EncodeTo with a Codec of
+// your choice for the newer streaming, pluggable-format API.
 func (ss *SortedSlice[K, V]) Save(filename string) error {
 	ss.RLock()
 	defer ss.RUnlock()
@@ -0,0 +1,197 @@
+package sortedslice
+
+import "sort"
+
+// batchOp is a single queued mutation in a Batch.
+type batchOp[K any, V any] struct {
+	key    K
+	value  V
+	delete bool
+}
+
+// Batch accumulates a set of Put/Delete operations to be applied to a
+// SortedSlice atomically via Write, modeled after goleveldb's Batch.
+type Batch[K any, V any] struct {
+	ops []batchOp[K, V]
+}
+
+// NewBatch creates an empty Batch.
+func NewBatch[K any, V any]() *Batch[K, V] {
+	return &Batch[K, V]{}
+}
+
+// Put queues an insert-or-update of key to value.
+func (b *Batch[K, V]) Put(key K, value V) {
+	b.ops = append(b.ops, batchOp[K, V]{key: key, value: value})
+}
+
+// Delete queues the removal of key.
+func (b *Batch[K, V]) Delete(key K) {
+	b.ops = append(b.ops, batchOp[K, V]{key: key, delete: true})
+}
+
+// Reset discards all queued operations so the Batch can be reused.
+func (b *Batch[K, V]) Reset() {
+	b.ops = b.ops[:0]
+}
+
+// Write applies all operations queued in b to ss atomically, under a
+// single write lock.
+func (ss *SortedSlice[K, V]) Write(b *Batch[K, V]) {
+	ss.Lock()
+	defer ss.Unlock()
+
+	for _, op := range b.ops {
+		index := sort.Search(len(ss.data), func(i int) bool {
+			return ss.cmp(ss.data[i].Key, op.key) >= 0
+		})
+
+		if op.delete {
+			if index < len(ss.data) && ss.cmp(ss.data[index].Key, op.key) == 0 {
+				ss.data = append(ss.data[:index], ss.data[index+1:]...)
+			}
+			continue
+		}
+
+		if index < len(ss.data) && ss.cmp(ss.data[index].Key, op.key) == 0 {
+			ss.data[index].Value = op.value
+		} else {
+			ss.data = append(ss.data, kv[K, V]{})
+			copy(ss.data[index+1:], ss.data[index:])
+			ss.data[index] = kv[K, V]{op.key, op.value}
+		}
+	}
+}
+
+// txnOp is the pending state of a single key inside a Txn's overlay.
+type txnOp[V any] struct {
+	value   V
+	deleted bool
+}
+
+// Txn is a transaction over a SortedSlice, modeled after goleveldb's
+// Transaction. Writes and deletes accumulate in an in-memory overlay; Get
+// and Range layer that overlay atop the base slice without blocking other
+// readers. Commit merges the overlay into the base slice with a single
+// two-pointer merge pass rather than one Add call per mutation.
+type Txn[K comparable, V any] struct {
+	ss      *SortedSlice[K, V]
+	overlay map[K]txnOp[V]
+}
+
+// Transaction starts a new Txn against ss.
+func (ss *SortedSlice[K, V]) Transaction() *Txn[K, V] {
+	return &Txn[K, V]{ss: ss, overlay: make(map[K]txnOp[V])}
+}
+
+// Put stages an insert-or-update of key to value in the transaction.
+func (t *Txn[K, V]) Put(key K, value V) {
+	t.overlay[key] = txnOp[V]{value: value}
+}
+
+// Delete stages the removal of key in the transaction.
+func (t *Txn[K, V]) Delete(key K) {
+	t.overlay[key] = txnOp[V]{deleted: true}
+}
+
+// Get returns the value for key, preferring the transaction's overlay over
+// the base slice.
+func (t *Txn[K, V]) Get(key K) (V, bool) {
+	if op, ok := t.overlay[key]; ok {
+		if op.deleted {
+			var zero V
+			return zero, false
+		}
+		return op.value, true
+	}
+	return t.ss.Get(key)
+}
+
+// Range iterates over the base slice merged with the transaction's
+// overlay, in key order, stopping early if f returns false.
+func (t *Txn[K, V]) Range(f func(k K, v V) bool) {
+	t.ss.RLock()
+	base := make([]kv[K, V], len(t.ss.data))
+	copy(base, t.ss.data)
+	cmp := t.ss.cmp
+	t.ss.RUnlock()
+
+	overlayKeys := make([]K, 0, len(t.overlay))
+	for k := range t.overlay {
+		overlayKeys = append(overlayKeys, k)
+	}
+	sort.Slice(overlayKeys, func(i, j int) bool { return cmp(overlayKeys[i], overlayKeys[j]) < 0 })
+
+	i, j := 0, 0
+	for i < len(base) || j < len(overlayKeys) {
+		if j < len(overlayKeys) && (i >= len(base) || cmp(overlayKeys[j], base[i].Key) <= 0) {
+			key := overlayKeys[j]
+			op := t.overlay[key]
+			if i < len(base) && cmp(base[i].Key, key) == 0 {
+				i++
+			}
+			j++
+			if op.deleted {
+				continue
+			}
+			if !f(key, op.value) {
+				return
+			}
+			continue
+		}
+
+		if !f(base[i].Key, base[i].Value) {
+			return
+		}
+		i++
+	}
+}
+
+// Commit merges the transaction's overlay into the base slice with a
+// single O(n+m) two-pointer merge pass, under one write lock, then clears
+// the overlay.
+func (t *Txn[K, V]) Commit() {
+	if len(t.overlay) == 0 {
+		return
+	}
+
+	cmp := t.ss.cmp
+
+	overlayKeys := make([]K, 0, len(t.overlay))
+	for k := range t.overlay {
+		overlayKeys = append(overlayKeys, k)
+	}
+	sort.Slice(overlayKeys, func(i, j int) bool { return cmp(overlayKeys[i], overlayKeys[j]) < 0 })
+
+	t.ss.Lock()
+	defer t.ss.Unlock()
+
+	merged := make([]kv[K, V], 0, len(t.ss.data)+len(overlayKeys))
+	i, j := 0, 0
+	for i < len(t.ss.data) || j < len(overlayKeys) {
+		if j < len(overlayKeys) && (i >= len(t.ss.data) || cmp(overlayKeys[j], t.ss.data[i].Key) <= 0) {
+			key := overlayKeys[j]
+			op := t.overlay[key]
+			if i < len(t.ss.data) && cmp(t.ss.data[i].Key, key) == 0 {
+				i++
+			}
+			j++
+			if op.deleted {
+				continue
+			}
+			merged = append(merged, kv[K, V]{key, op.value})
+			continue
+		}
+
+		merged = append(merged, t.ss.data[i])
+		i++
+	}
+
+	t.ss.data = merged
+	t.overlay = make(map[K]txnOp[V])
+}
+
+// Discard drops the transaction's overlay without applying it.
+func (t *Txn[K, V]) Discard() {
+	t.overlay = make(map[K]txnOp[V])
+}
@@ -0,0 +1,122 @@
+package sortedslice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatchWrite(t *testing.T) {
+	ss := New[int, string]()
+	ss.Add(1, "one")
+	ss.Add(2, "two")
+
+	b := NewBatch[int, string]()
+	b.Put(2, "TWO")
+	b.Put(3, "three")
+	b.Delete(1)
+
+	ss.Write(b)
+
+	_, found := ss.Get(1)
+	assert.False(t, found)
+
+	val, found := ss.Get(2)
+	assert.True(t, found)
+	assert.Equal(t, "TWO", val)
+
+	val, found = ss.Get(3)
+	assert.True(t, found)
+	assert.Equal(t, "three", val)
+}
+
+func TestBatchReset(t *testing.T) {
+	b := NewBatch[int, string]()
+	b.Put(1, "one")
+	b.Reset()
+
+	ss := New[int, string]()
+	ss.Write(b)
+	assert.Equal(t, 0, ss.Len())
+}
+
+func TestTxnGetLayersOverlay(t *testing.T) {
+	ss := New[int, string]()
+	ss.Add(1, "one")
+	ss.Add(2, "two")
+
+	txn := ss.Transaction()
+	txn.Put(2, "TWO")
+	txn.Put(3, "three")
+	txn.Delete(1)
+
+	val, found := txn.Get(2)
+	assert.True(t, found)
+	assert.Equal(t, "TWO", val)
+
+	_, found = txn.Get(1)
+	assert.False(t, found)
+
+	val, found = ss.Get(2)
+	assert.True(t, found)
+	assert.Equal(t, "two", val)
+}
+
+func TestTxnRange(t *testing.T) {
+	ss := New[int, string]()
+	ss.Add(1, "one")
+	ss.Add(3, "three")
+
+	txn := ss.Transaction()
+	txn.Put(2, "two")
+	txn.Delete(1)
+
+	keys := []int{}
+	values := []string{}
+	txn.Range(func(k int, v string) bool {
+		keys = append(keys, k)
+		values = append(values, v)
+		return true
+	})
+	assert.Equal(t, []int{2, 3}, keys)
+	assert.Equal(t, []string{"two", "three"}, values)
+}
+
+func TestTxnCommit(t *testing.T) {
+	ss := New[int, string]()
+	ss.Add(1, "one")
+	ss.Add(3, "three")
+
+	txn := ss.Transaction()
+	txn.Put(2, "two")
+	txn.Delete(1)
+	txn.Commit()
+
+	_, found := ss.Get(1)
+	assert.False(t, found)
+
+	val, found := ss.Get(2)
+	assert.True(t, found)
+	assert.Equal(t, "two", val)
+
+	keys := []int{}
+	ss.Range(func(k int, v string) bool {
+		keys = append(keys, k)
+		return true
+	})
+	assert.Equal(t, []int{2, 3}, keys)
+}
+
+func TestTxnDiscard(t *testing.T) {
+	ss := New[int, string]()
+	ss.Add(1, "one")
+
+	txn := ss.Transaction()
+	txn.Put(2, "two")
+	txn.Discard()
+	txn.Commit()
+
+	assert.Equal(t, 1, ss.Len())
+	_, found := ss.Get(2)
+	assert.False(t, found)
+}
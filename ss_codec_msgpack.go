@@ -0,0 +1,22 @@
+//go:build msgpack
+
+package sortedslice
+
+import (
+	"io"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// MsgpackCodec encodes values using MessagePack. It is only compiled in
+// with the msgpack build tag, since it pulls in an optional third-party
+// dependency that most callers of this package don't need.
+type MsgpackCodec struct{}
+
+func (MsgpackCodec) NewEncoder(w io.Writer) Encoder {
+	return msgpack.NewEncoder(w)
+}
+
+func (MsgpackCodec) NewDecoder(r io.Reader) Decoder {
+	return msgpack.NewDecoder(r)
+}
@@ -0,0 +1,131 @@
+package sortedslice
+
+import (
+	"bytes"
+	"encoding/gob"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSaveAndLoadStillWorks(t *testing.T) {
+	ss := New[int, string]()
+	ss.Add(1, "one")
+	ss.Add(2, "two")
+
+	filename := "test_codec_save.gob"
+	err := ss.Save(filename)
+	assert.NoError(t, err)
+	defer os.Remove(filename)
+
+	newSS := New[int, string]()
+	err = newSS.Load(filename)
+	assert.NoError(t, err)
+
+	assert.Equal(t, ss.Len(), newSS.Len())
+	val, found := newSS.Get(1)
+	assert.True(t, found)
+	assert.Equal(t, "one", val)
+}
+
+func TestLoadReadsPreExistingSingleValueGobFile(t *testing.T) {
+	filename := "test_codec_legacy.gob"
+	defer os.Remove(filename)
+
+	// Mimics a file written by Save before the Codec abstraction existed:
+	// the whole backing slice gob-encoded in a single Encode call, with no
+	// leading count and no per-entry framing.
+	legacy := []kv[int, string]{
+		{Key: 1, Value: "one"},
+		{Key: 2, Value: "two"},
+	}
+	file, err := os.Create(filename)
+	assert.NoError(t, err)
+	err = gob.NewEncoder(file).Encode(legacy)
+	assert.NoError(t, err)
+	assert.NoError(t, file.Close())
+
+	ss := New[int, string]()
+	err = ss.Load(filename)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, ss.Len())
+	val, found := ss.Get(1)
+	assert.True(t, found)
+	assert.Equal(t, "one", val)
+}
+
+func TestWriteToReadFromSatisfyStdlibInterfaces(t *testing.T) {
+	ss := New[int, string]()
+	ss.Add(1, "one")
+	ss.Add(2, "two")
+
+	var buf bytes.Buffer
+	var writerTo io.WriterTo = ss
+	n, err := writerTo.WriteTo(&buf)
+	assert.NoError(t, err)
+	assert.True(t, n > 0)
+
+	newSS := New[int, string]()
+	var readerFrom io.ReaderFrom = newSS
+	_, err = readerFrom.ReadFrom(&buf)
+	assert.NoError(t, err)
+
+	val, found := newSS.Get(1)
+	assert.True(t, found)
+	assert.Equal(t, "one", val)
+}
+
+func TestEncodeToDecodeFromJSONCodec(t *testing.T) {
+	ss := New[int, string]()
+	ss.Add(2, "two")
+	ss.Add(1, "one")
+	ss.Add(3, "three")
+
+	var buf bytes.Buffer
+	n, err := ss.EncodeTo(&buf, JSONCodec{})
+	assert.NoError(t, err)
+	assert.True(t, n > 0)
+
+	newSS := New[int, string]()
+	_, err = newSS.DecodeFrom(&buf, JSONCodec{})
+	assert.NoError(t, err)
+
+	keys := []int{}
+	newSS.Range(func(k int, v string) bool {
+		keys = append(keys, k)
+		return true
+	})
+	assert.Equal(t, []int{1, 2, 3}, keys)
+}
+
+func TestDecodeFromUnsortedStream(t *testing.T) {
+	var buf bytes.Buffer
+	codec := JSONCodec{}
+
+	entries := []kv[int, string]{
+		{Key: 3, Value: "three"},
+		{Key: 1, Value: "one"},
+		{Key: 2, Value: "two"},
+	}
+	enc := codec.NewEncoder(&buf)
+	err := enc.Encode(len(entries))
+	assert.NoError(t, err)
+	for _, e := range entries {
+		err := enc.Encode(e)
+		assert.NoError(t, err)
+	}
+
+	ss := New[int, string]()
+	_, err = ss.DecodeFrom(&buf, codec)
+	assert.NoError(t, err)
+
+	keys := []int{}
+	ss.Range(func(k int, v string) bool {
+		keys = append(keys, k)
+		return true
+	})
+	assert.Equal(t, []int{1, 2, 3}, keys)
+}
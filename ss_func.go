@@ -0,0 +1,14 @@
+package sortedslice
+
+// NewFunc creates a new SortedSlice ordered by cmp instead of K's natural
+// ordering, for key types that constraints.Ordered can't express, such as
+// composite structs or custom byte-lex keys. cmp must follow the
+// func(a, b) int convention used by slices.SortFunc: negative if a < b,
+// zero if equal, positive if a > b.
+//
+// The returned value is a plain *SortedSlice, not a distinct type, so it
+// carries every SortedSlice method - range scans, Batch/Txn, Merge/BulkLoad,
+// Codec - with no separate implementation to keep in sync.
+func NewFunc[K comparable, V any](cmp func(a, b K) int) *SortedSlice[K, V] {
+	return &SortedSlice[K, V]{cmp: cmp}
+}
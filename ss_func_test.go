@@ -0,0 +1,73 @@
+package sortedslice
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFuncAndAddGet(t *testing.T) {
+	ss := NewFunc[string, int](func(a, b string) int {
+		if a < b {
+			return -1
+		} else if a > b {
+			return 1
+		}
+		return 0
+	})
+	ss.Add("banana", 2)
+	ss.Add("apple", 1)
+	ss.Add("cherry", 3)
+
+	val, found := ss.Get("apple")
+	assert.True(t, found)
+	assert.Equal(t, 1, val)
+
+	assert.Equal(t, "apple", ss.FirstKey())
+	assert.Equal(t, "cherry", ss.LastKey())
+}
+
+func TestSortedSliceFuncDelete(t *testing.T) {
+	ss := NewFunc[int, string](func(a, b int) int { return a - b })
+	ss.Add(1, "one")
+	ss.Add(2, "two")
+
+	val, found := ss.Delete(1)
+	assert.True(t, found)
+	assert.Equal(t, "one", val)
+	assert.Equal(t, 1, ss.Len())
+}
+
+func TestSortedSliceFuncRange(t *testing.T) {
+	ss := NewFunc[int, string](func(a, b int) int { return a - b })
+	ss.Add(3, "three")
+	ss.Add(1, "one")
+	ss.Add(2, "two")
+
+	keys := []int{}
+	ss.Range(func(k int, v string) bool {
+		keys = append(keys, k)
+		return true
+	})
+	assert.Equal(t, []int{1, 2, 3}, keys)
+}
+
+func TestSortedSliceFuncSaveAndLoad(t *testing.T) {
+	ss := NewFunc[int, string](func(a, b int) int { return a - b })
+	ss.Add(1, "one")
+	ss.Add(2, "two")
+
+	filename := "test_func_save.gob"
+	err := ss.Save(filename)
+	assert.NoError(t, err)
+	defer os.Remove(filename)
+
+	newSS := NewFunc[int, string](func(a, b int) int { return a - b })
+	err = newSS.Load(filename)
+	assert.NoError(t, err)
+
+	val, found := newSS.Get(1)
+	assert.True(t, found)
+	assert.Equal(t, "one", val)
+}
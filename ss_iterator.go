@@ -0,0 +1,97 @@
+package sortedslice
+
+import "sort"
+
+// Iterator provides ordered, snapshot-isolated access to the key-value
+// pairs of a SortedSlice, modeled on goleveldb-style iterators. It is
+// created by NewIterator and holds a private copy of the data as it
+// existed at creation time, so concurrent Add/Delete calls on the source
+// SortedSlice neither corrupt an in-progress scan nor require the caller
+// to hold the slice's lock for the whole iteration.
+type Iterator[K comparable, V any] interface {
+	// Seek positions the iterator at the smallest key >= key and reports
+	// whether the iterator is valid afterwards.
+	Seek(key K) bool
+	// SeekToFirst positions the iterator at the first key.
+	SeekToFirst()
+	// SeekToLast positions the iterator at the last key.
+	SeekToLast()
+	// Next moves the iterator to the next key and reports whether it is valid.
+	Next() bool
+	// Prev moves the iterator to the previous key and reports whether it is valid.
+	Prev() bool
+	// Valid reports whether the iterator is positioned at an existing entry.
+	Valid() bool
+	// Key returns the key at the current position. It panics if !Valid().
+	Key() K
+	// Value returns the value at the current position. It panics if !Valid().
+	Value() V
+	// Release releases the iterator's snapshot. The iterator must not be
+	// used after calling Release.
+	Release()
+}
+
+// sliceIterator is the Iterator implementation returned by NewIterator.
+type sliceIterator[K comparable, V any] struct {
+	data []kv[K, V]
+	cmp  func(a, b K) int
+	pos  int
+}
+
+// NewIterator returns an Iterator over a snapshot of ss taken at the time
+// of the call. The snapshot is a shallow copy of the backing slice, so it
+// is unaffected by later Add/Delete calls on ss.
+func (ss *SortedSlice[K, V]) NewIterator() Iterator[K, V] {
+	ss.RLock()
+	defer ss.RUnlock()
+
+	snapshot := make([]kv[K, V], len(ss.data))
+	copy(snapshot, ss.data)
+	return &sliceIterator[K, V]{data: snapshot, cmp: ss.cmp, pos: -1}
+}
+
+func (it *sliceIterator[K, V]) Seek(key K) bool {
+	it.pos = sort.Search(len(it.data), func(i int) bool {
+		return it.cmp(it.data[i].Key, key) >= 0
+	})
+	return it.Valid()
+}
+
+func (it *sliceIterator[K, V]) SeekToFirst() {
+	it.pos = 0
+}
+
+func (it *sliceIterator[K, V]) SeekToLast() {
+	it.pos = len(it.data) - 1
+}
+
+func (it *sliceIterator[K, V]) Next() bool {
+	if it.pos < len(it.data) {
+		it.pos++
+	}
+	return it.Valid()
+}
+
+func (it *sliceIterator[K, V]) Prev() bool {
+	if it.pos >= 0 {
+		it.pos--
+	}
+	return it.Valid()
+}
+
+func (it *sliceIterator[K, V]) Valid() bool {
+	return it.pos >= 0 && it.pos < len(it.data)
+}
+
+func (it *sliceIterator[K, V]) Key() K {
+	return it.data[it.pos].Key
+}
+
+func (it *sliceIterator[K, V]) Value() V {
+	return it.data[it.pos].Value
+}
+
+func (it *sliceIterator[K, V]) Release() {
+	it.data = nil
+	it.pos = -1
+}
@@ -0,0 +1,83 @@
+package sortedslice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIteratorForward(t *testing.T) {
+	ss := New[int, string]()
+	ss.Add(1, "one")
+	ss.Add(2, "two")
+	ss.Add(3, "three")
+
+	it := ss.NewIterator()
+	defer it.Release()
+
+	it.SeekToFirst()
+	keys := []int{}
+	for it.Valid() {
+		keys = append(keys, it.Key())
+		it.Next()
+	}
+	assert.Equal(t, []int{1, 2, 3}, keys)
+}
+
+func TestIteratorBackward(t *testing.T) {
+	ss := New[int, string]()
+	ss.Add(1, "one")
+	ss.Add(2, "two")
+	ss.Add(3, "three")
+
+	it := ss.NewIterator()
+	defer it.Release()
+
+	it.SeekToLast()
+	keys := []int{}
+	for it.Valid() {
+		keys = append(keys, it.Key())
+		it.Prev()
+	}
+	assert.Equal(t, []int{3, 2, 1}, keys)
+}
+
+func TestIteratorSeek(t *testing.T) {
+	ss := New[int, string]()
+	ss.Add(1, "one")
+	ss.Add(3, "three")
+	ss.Add(5, "five")
+
+	it := ss.NewIterator()
+	defer it.Release()
+
+	assert.True(t, it.Seek(3))
+	assert.Equal(t, 3, it.Key())
+	assert.Equal(t, "three", it.Value())
+
+	assert.True(t, it.Seek(4))
+	assert.Equal(t, 5, it.Key())
+
+	assert.False(t, it.Seek(6))
+	assert.False(t, it.Valid())
+}
+
+func TestIteratorSnapshotIsolation(t *testing.T) {
+	ss := New[int, string]()
+	ss.Add(1, "one")
+	ss.Add(2, "two")
+
+	it := ss.NewIterator()
+	defer it.Release()
+
+	ss.Add(3, "three")
+	ss.Delete(1)
+
+	it.SeekToFirst()
+	keys := []int{}
+	for it.Valid() {
+		keys = append(keys, it.Key())
+		it.Next()
+	}
+	assert.Equal(t, []int{1, 2}, keys)
+}
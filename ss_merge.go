@@ -0,0 +1,89 @@
+package sortedslice
+
+import "sort"
+
+// mergeData performs the linear two-pointer merge shared by Merge and
+// MergeInto. Both a and b must already be sorted according to cmp.
+func mergeData[K comparable, V any](a, b []kv[K, V], cmp func(x, y K) int, resolve func(k K, av, bv V) V) []kv[K, V] {
+	merged := make([]kv[K, V], 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case cmp(a[i].Key, b[j].Key) < 0:
+			merged = append(merged, a[i])
+			i++
+		case cmp(a[i].Key, b[j].Key) > 0:
+			merged = append(merged, b[j])
+			j++
+		default:
+			merged = append(merged, kv[K, V]{
+				Key:   a[i].Key,
+				Value: resolve(a[i].Key, a[i].Value, b[j].Value),
+			})
+			i++
+			j++
+		}
+	}
+	merged = append(merged, a[i:]...)
+	merged = append(merged, b[j:]...)
+	return merged
+}
+
+// Merge combines a and b into a new SortedSlice using a linear two-pointer
+// merge of their already-sorted backing slices, which is O(n+m) rather
+// than the O((n+m)*(n+m)) of inserting every element with Add. When both
+// a and b contain the same key, resolve decides the value that wins. The
+// merged slice uses a's comparator.
+func Merge[K comparable, V any](a, b *SortedSlice[K, V], resolve func(k K, av, bv V) V) *SortedSlice[K, V] {
+	a.RLock()
+	defer a.RUnlock()
+	b.RLock()
+	defer b.RUnlock()
+
+	return &SortedSlice[K, V]{data: mergeData(a.data, b.data, a.cmp, resolve), cmp: a.cmp}
+}
+
+// MergeInto merges other into ss in place, using the same O(n+m)
+// two-pointer merge as Merge. On a key collision, resolve decides the
+// value that wins. ss is write-locked for the whole read-merge-install
+// sequence, so a concurrent Add/Delete/Write/Commit on ss can't land in
+// the gap between computing the merge and installing it and get silently
+// overwritten.
+func (ss *SortedSlice[K, V]) MergeInto(other *SortedSlice[K, V], resolve func(k K, av, bv V) V) {
+	ss.Lock()
+	defer ss.Unlock()
+
+	other.RLock()
+	defer other.RUnlock()
+
+	ss.data = mergeData(ss.data, other.data, ss.cmp, resolve)
+}
+
+// Pair is a key-value pair as accepted by BulkLoad.
+type Pair[K any, V any] struct {
+	Key   K
+	Value V
+}
+
+// BulkLoad replaces ss's contents with pairs, sorting and de-duping them
+// once in O(n log n) rather than inserting each pair with Add, which would
+// be O(n^2) for unsorted input. When a key appears more than once, the
+// last occurrence in pairs wins.
+func (ss *SortedSlice[K, V]) BulkLoad(pairs []Pair[K, V]) {
+	sorted := make([]Pair[K, V], len(pairs))
+	copy(sorted, pairs)
+	sort.SliceStable(sorted, func(i, j int) bool { return ss.cmp(sorted[i].Key, sorted[j].Key) < 0 })
+
+	data := make([]kv[K, V], 0, len(sorted))
+	for i, p := range sorted {
+		if i > 0 && ss.cmp(p.Key, sorted[i-1].Key) == 0 {
+			data[len(data)-1].Value = p.Value
+			continue
+		}
+		data = append(data, kv[K, V]{p.Key, p.Value})
+	}
+
+	ss.Lock()
+	defer ss.Unlock()
+	ss.data = data
+}
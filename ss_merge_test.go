@@ -0,0 +1,126 @@
+package sortedslice
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMerge(t *testing.T) {
+	a := New[int, string]()
+	a.Add(1, "a1")
+	a.Add(2, "a2")
+
+	b := New[int, string]()
+	b.Add(2, "b2")
+	b.Add(3, "b3")
+
+	merged := Merge(a, b, func(k int, av, bv string) string {
+		return av + bv
+	})
+
+	keys := []int{}
+	values := []string{}
+	merged.Range(func(k int, v string) bool {
+		keys = append(keys, k)
+		values = append(values, v)
+		return true
+	})
+
+	assert.Equal(t, []int{1, 2, 3}, keys)
+	assert.Equal(t, []string{"a1", "a2b2", "b3"}, values)
+}
+
+func TestMergeInto(t *testing.T) {
+	a := New[int, string]()
+	a.Add(1, "a1")
+
+	b := New[int, string]()
+	b.Add(2, "b2")
+
+	a.MergeInto(b, func(k int, av, bv string) string {
+		return av + bv
+	})
+
+	assert.Equal(t, 2, a.Len())
+	val, found := a.Get(2)
+	assert.True(t, found)
+	assert.Equal(t, "b2", val)
+}
+
+func TestMergeIntoConcurrentWriteNotLost(t *testing.T) {
+	ss := New[int, string]()
+	other := New[int, string]()
+	other.Add(100, "other")
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 1000; i++ {
+			ss.Add(1, "concurrent")
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 1000; i++ {
+			ss.MergeInto(other, func(k int, av, bv string) string { return bv })
+		}
+	}()
+
+	wg.Wait()
+
+	// Whichever of the two goroutines ran last, the key written by Add
+	// must never have been silently wiped out by an in-flight MergeInto
+	// installing a merge snapshot computed before the Add happened.
+	val, found := ss.Get(1)
+	assert.True(t, found)
+	assert.Equal(t, "concurrent", val)
+}
+
+func TestBulkLoad(t *testing.T) {
+	ss := New[int, string]()
+
+	ss.BulkLoad([]Pair[int, string]{
+		{Key: 3, Value: "three"},
+		{Key: 1, Value: "one"},
+		{Key: 2, Value: "two"},
+		{Key: 1, Value: "ONE"},
+	})
+
+	assert.Equal(t, 3, ss.Len())
+	val, found := ss.Get(1)
+	assert.True(t, found)
+	assert.Equal(t, "ONE", val)
+
+	keys := []int{}
+	ss.Range(func(k int, v string) bool {
+		keys = append(keys, k)
+		return true
+	})
+	assert.Equal(t, []int{1, 2, 3}, keys)
+}
+
+func TestBulkLoadLastOccurrenceWinsUnderDuplicates(t *testing.T) {
+	ss := New[int, int]()
+
+	// Many duplicates of a few keys, in an order an unstable sort is
+	// likely to scramble, to pin down that the last occurrence in pairs
+	// always wins regardless of sort implementation.
+	pairs := make([]Pair[int, int], 0, 500)
+	for i := 0; i < 500; i++ {
+		pairs = append(pairs, Pair[int, int]{Key: i % 5, Value: i})
+	}
+
+	ss.BulkLoad(pairs)
+
+	assert.Equal(t, 5, ss.Len())
+	for key := 0; key < 5; key++ {
+		val, found := ss.Get(key)
+		assert.True(t, found)
+		assert.Equal(t, 495+key, val)
+	}
+}
@@ -0,0 +1,128 @@
+package sortedslice
+
+import "sort"
+
+// RangeFrom iterates over all key-value pairs with a key >= start, in
+// ascending order, stopping early if f returns false. The start index is
+// located with sort.Search in O(log n); the scan itself is linear.
+func (ss *SortedSlice[K, V]) RangeFrom(start K, f func(k K, v V) bool) {
+	ss.RLock()
+	defer ss.RUnlock()
+
+	index := sort.Search(len(ss.data), func(i int) bool {
+		return ss.cmp(ss.data[i].Key, start) >= 0
+	})
+
+	for i := index; i < len(ss.data); i++ {
+		if !f(ss.data[i].Key, ss.data[i].Value) {
+			break
+		}
+	}
+}
+
+// RangeFromBackward iterates over all key-value pairs with a key <= start,
+// in descending order, stopping early if f returns false.
+func (ss *SortedSlice[K, V]) RangeFromBackward(start K, f func(k K, v V) bool) {
+	ss.RLock()
+	defer ss.RUnlock()
+
+	index := sort.Search(len(ss.data), func(i int) bool {
+		return ss.cmp(ss.data[i].Key, start) > 0
+	}) - 1
+
+	for i := index; i >= 0; i-- {
+		if !f(ss.data[i].Key, ss.data[i].Value) {
+			break
+		}
+	}
+}
+
+// RangeBetween iterates over all key-value pairs with a key in [start, end]
+// when inclusive is true, or [start, end) when inclusive is false, in
+// ascending order. Iteration stops early if f returns false.
+func (ss *SortedSlice[K, V]) RangeBetween(start, end K, inclusive bool, f func(k K, v V) bool) {
+	ss.RLock()
+	defer ss.RUnlock()
+
+	index := sort.Search(len(ss.data), func(i int) bool {
+		return ss.cmp(ss.data[i].Key, start) >= 0
+	})
+
+	for i := index; i < len(ss.data); i++ {
+		key := ss.data[i].Key
+		if inclusive {
+			if ss.cmp(key, end) > 0 {
+				break
+			}
+		} else if ss.cmp(key, end) >= 0 {
+			break
+		}
+		if !f(key, ss.data[i].Value) {
+			break
+		}
+	}
+}
+
+// RangeBetweenBackward iterates over all key-value pairs with a key in
+// [start, end] when inclusive is true, or (start, end] when inclusive is
+// false, in descending order. Iteration stops early if f returns false.
+func (ss *SortedSlice[K, V]) RangeBetweenBackward(start, end K, inclusive bool, f func(k K, v V) bool) {
+	ss.RLock()
+	defer ss.RUnlock()
+
+	index := sort.Search(len(ss.data), func(i int) bool {
+		return ss.cmp(ss.data[i].Key, end) > 0
+	}) - 1
+
+	for i := index; i >= 0; i-- {
+		key := ss.data[i].Key
+		if inclusive {
+			if ss.cmp(key, start) < 0 {
+				break
+			}
+		} else if ss.cmp(key, start) <= 0 {
+			break
+		}
+		if !f(key, ss.data[i].Value) {
+			break
+		}
+	}
+}
+
+// Count returns the number of keys in [start, end].
+func (ss *SortedSlice[K, V]) Count(start, end K) int {
+	ss.RLock()
+	defer ss.RUnlock()
+
+	lo := sort.Search(len(ss.data), func(i int) bool {
+		return ss.cmp(ss.data[i].Key, start) >= 0
+	})
+	hi := sort.Search(len(ss.data), func(i int) bool {
+		return ss.cmp(ss.data[i].Key, end) > 0
+	})
+	if hi < lo {
+		return 0
+	}
+	return hi - lo
+}
+
+// DeleteRange removes all keys in [start, end] and returns the number of
+// entries removed. It is implemented as a single reslice rather than
+// repeated shifting, so it is O(n) regardless of the number of keys removed.
+func (ss *SortedSlice[K, V]) DeleteRange(start, end K) int {
+	ss.Lock()
+	defer ss.Unlock()
+
+	lo := sort.Search(len(ss.data), func(i int) bool {
+		return ss.cmp(ss.data[i].Key, start) >= 0
+	})
+	hi := sort.Search(len(ss.data), func(i int) bool {
+		return ss.cmp(ss.data[i].Key, end) > 0
+	})
+	if hi <= lo {
+		return 0
+	}
+
+	ss.data = append(ss.data[:lo], ss.data[hi:]...)
+	return hi - lo
+}
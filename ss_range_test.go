@@ -0,0 +1,95 @@
+package sortedslice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newRangeTestSlice() *SortedSlice[int, string] {
+	ss := New[int, string]()
+	for _, k := range []int{1, 2, 3, 4, 5} {
+		ss.Add(k, "v")
+	}
+	return ss
+}
+
+func TestRangeFrom(t *testing.T) {
+	ss := newRangeTestSlice()
+
+	keys := []int{}
+	ss.RangeFrom(3, func(k int, v string) bool {
+		keys = append(keys, k)
+		return true
+	})
+	assert.Equal(t, []int{3, 4, 5}, keys)
+}
+
+func TestRangeFromBackward(t *testing.T) {
+	ss := newRangeTestSlice()
+
+	keys := []int{}
+	ss.RangeFromBackward(3, func(k int, v string) bool {
+		keys = append(keys, k)
+		return true
+	})
+	assert.Equal(t, []int{3, 2, 1}, keys)
+}
+
+func TestRangeBetween(t *testing.T) {
+	ss := newRangeTestSlice()
+
+	keys := []int{}
+	ss.RangeBetween(2, 4, true, func(k int, v string) bool {
+		keys = append(keys, k)
+		return true
+	})
+	assert.Equal(t, []int{2, 3, 4}, keys)
+
+	keys = []int{}
+	ss.RangeBetween(2, 4, false, func(k int, v string) bool {
+		keys = append(keys, k)
+		return true
+	})
+	assert.Equal(t, []int{2, 3}, keys)
+}
+
+func TestRangeBetweenBackward(t *testing.T) {
+	ss := newRangeTestSlice()
+
+	keys := []int{}
+	ss.RangeBetweenBackward(2, 4, true, func(k int, v string) bool {
+		keys = append(keys, k)
+		return true
+	})
+	assert.Equal(t, []int{4, 3, 2}, keys)
+
+	keys = []int{}
+	ss.RangeBetweenBackward(2, 4, false, func(k int, v string) bool {
+		keys = append(keys, k)
+		return true
+	})
+	assert.Equal(t, []int{4, 3}, keys)
+}
+
+func TestCount(t *testing.T) {
+	ss := newRangeTestSlice()
+
+	assert.Equal(t, 3, ss.Count(2, 4))
+	assert.Equal(t, 0, ss.Count(10, 20))
+}
+
+func TestDeleteRange(t *testing.T) {
+	ss := newRangeTestSlice()
+
+	removed := ss.DeleteRange(2, 4)
+	assert.Equal(t, 3, removed)
+	assert.Equal(t, 2, ss.Len())
+
+	_, found := ss.Get(3)
+	assert.False(t, found)
+
+	val, found := ss.Get(1)
+	assert.True(t, found)
+	assert.Equal(t, "v", val)
+}